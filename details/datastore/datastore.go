@@ -0,0 +1,72 @@
+// Package datastore provides a health.DetailsProvider for an *sql.DB, reporting connection
+// pool utilization and ping latency as RFC Health Check Response Format details.
+package datastore
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"github.com/nelkinda/health-go"
+	"time"
+)
+
+// DataStore is a health.DetailsProvider that pings an *sql.DB and reports its connection pool statistics.
+type DataStore struct {
+	health.AuthorizeAll
+	db          *sql.DB
+	componentID string
+	timeout     time.Duration
+}
+
+// New creates a health.DetailsProvider for db. componentID identifies the datastore in the
+// details keys, e.g. "postgres:responseTime" and "postgres:connections". timeout bounds how
+// long PingContext is allowed to take; a non-positive timeout means no timeout is applied.
+func New(componentID string, db *sql.DB, timeout time.Duration) *DataStore {
+	return &DataStore{db: db, componentID: componentID, timeout: timeout}
+}
+
+// HealthDetails implements health.DetailsProvider.
+func (d *DataStore) HealthDetails() map[string][]health.Details {
+	ctx := context.Background()
+	if d.timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, d.timeout)
+		defer cancel()
+	}
+	now := time.Now().Format(health.ISO8601)
+	start := time.Now()
+	err := d.db.PingContext(ctx)
+	responseTime := time.Since(start)
+
+	status := health.Pass
+	output := ""
+	if err != nil {
+		status = health.Fail
+		output = fmt.Sprintf("ping failed: %v", err)
+	}
+	stats := d.db.Stats()
+
+	return map[string][]health.Details{
+		d.componentID + ":responseTime": {
+			{
+				ComponentID:   d.componentID,
+				ComponentType: "datastore",
+				ObservedValue: float64(responseTime.Milliseconds()),
+				ObservedUnit:  "ms",
+				Status:        status,
+				Time:          now,
+				Output:        output,
+			},
+		},
+		d.componentID + ":connections": {
+			{
+				ComponentID:   d.componentID,
+				ComponentType: "datastore",
+				ObservedValue: stats.OpenConnections,
+				ObservedUnit:  "connection",
+				Status:        health.Pass,
+				Time:          now,
+			},
+		},
+	}
+}
@@ -0,0 +1,75 @@
+package datastore
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"github.com/nelkinda/health-go"
+	"testing"
+	"time"
+)
+
+// fakeDriver is a minimal database/sql/driver.Driver whose Ping outcome is fixed at
+// registration, so HealthDetails can be exercised without a real database.
+type fakeDriver struct{ pingErr error }
+
+func (d fakeDriver) Open(name string) (driver.Conn, error) {
+	return fakeConn{pingErr: d.pingErr}, nil
+}
+
+type fakeConn struct{ pingErr error }
+
+func (c fakeConn) Prepare(query string) (driver.Stmt, error) { return nil, errors.New("not implemented") }
+func (c fakeConn) Close() error                              { return nil }
+func (c fakeConn) Begin() (driver.Tx, error)                  { return nil, errors.New("not implemented") }
+func (c fakeConn) Ping(ctx context.Context) error             { return c.pingErr }
+
+func openFakeDB(t *testing.T, name string, pingErr error) *sql.DB {
+	t.Helper()
+	sql.Register(name, fakeDriver{pingErr: pingErr})
+	db, err := sql.Open(name, "")
+	if err != nil {
+		t.Fatalf("sql.Open: %v", err)
+	}
+	t.Cleanup(func() { db.Close() })
+	return db
+}
+
+func TestHealthDetailsReportsPassOnSuccessfulPing(t *testing.T) {
+	db := openFakeDB(t, "fake-pass", nil)
+	ds := New("postgres", db, time.Second)
+
+	details := ds.HealthDetails()
+
+	rt := details["postgres:responseTime"]
+	if len(rt) != 1 || rt[0].Status != health.Pass || rt[0].ComponentID != "postgres" {
+		t.Fatalf("expected a passing responseTime entry for postgres, got %+v", rt)
+	}
+	if _, ok := details["postgres:connections"]; !ok {
+		t.Errorf("expected a connections entry, got %+v", details)
+	}
+}
+
+func TestHealthDetailsReportsFailOnPingError(t *testing.T) {
+	db := openFakeDB(t, "fake-fail", errors.New("connection refused"))
+	ds := New("postgres", db, time.Second)
+
+	details := ds.HealthDetails()
+
+	rt := details["postgres:responseTime"]
+	if len(rt) != 1 || rt[0].Status != health.Fail || rt[0].Output == "" {
+		t.Fatalf("expected a failing responseTime entry with output, got %+v", rt)
+	}
+}
+
+func TestZeroTimeoutMeansNoTimeout(t *testing.T) {
+	db := openFakeDB(t, "fake-zero-timeout", nil)
+	ds := New("postgres", db, 0)
+
+	details := ds.HealthDetails()
+
+	if rt := details["postgres:responseTime"]; len(rt) != 1 || rt[0].Status != health.Pass {
+		t.Errorf("a zero timeout should mean no deadline, not an immediate failure; got %+v", rt)
+	}
+}
@@ -0,0 +1,81 @@
+// Package httpurl provides a health.DetailsProvider that issues an HTTP request to a URL,
+// reporting status-code-derived health and response latency as RFC Health Check Response
+// Format details.
+package httpurl
+
+import (
+	"context"
+	"fmt"
+	"github.com/nelkinda/health-go"
+	"net/http"
+	"time"
+)
+
+// HTTPURL is a health.DetailsProvider that issues an HTTP request to a URL and derives health from the response status code.
+type HTTPURL struct {
+	health.AuthorizeAll
+	componentID string
+	url         string
+	method      string
+	client      *http.Client
+	timeout     time.Duration
+}
+
+// New creates a health.DetailsProvider that issues method (http.MethodGet or http.MethodHead)
+// requests to url, using client (or http.DefaultClient if nil), bounded by timeout; a
+// non-positive timeout means no timeout is applied. componentID identifies the dependency in
+// the details key, e.g. "payments-api:responseTime".
+func New(componentID, method, url string, client *http.Client, timeout time.Duration) *HTTPURL {
+	if client == nil {
+		client = http.DefaultClient
+	}
+	return &HTTPURL{componentID: componentID, url: url, method: method, client: client, timeout: timeout}
+}
+
+// HealthDetails implements health.DetailsProvider.
+func (h *HTTPURL) HealthDetails() map[string][]health.Details {
+	now := time.Now().Format(health.ISO8601)
+	start := time.Now()
+	status, output := h.check()
+	responseTime := time.Since(start)
+
+	return map[string][]health.Details{
+		h.componentID + ":responseTime": {
+			{
+				ComponentID:   h.componentID,
+				ComponentType: "component",
+				ObservedValue: float64(responseTime.Milliseconds()),
+				ObservedUnit:  "ms",
+				Status:        status,
+				Time:          now,
+				Output:        output,
+			},
+		},
+	}
+}
+
+func (h *HTTPURL) check() (health.Status, string) {
+	ctx := context.Background()
+	if h.timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, h.timeout)
+		defer cancel()
+	}
+	req, err := http.NewRequestWithContext(ctx, h.method, h.url, nil)
+	if err != nil {
+		return health.Fail, fmt.Sprintf("building request for %s failed: %v", h.url, err)
+	}
+	resp, err := h.client.Do(req)
+	if err != nil {
+		return health.Fail, fmt.Sprintf("request to %s failed: %v", h.url, err)
+	}
+	defer resp.Body.Close()
+	switch {
+	case resp.StatusCode >= 500:
+		return health.Fail, fmt.Sprintf("received status %d", resp.StatusCode)
+	case resp.StatusCode >= 400:
+		return health.Warn, fmt.Sprintf("received status %d", resp.StatusCode)
+	default:
+		return health.Pass, ""
+	}
+}
@@ -0,0 +1,82 @@
+package httpurl
+
+import (
+	"github.com/nelkinda/health-go"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestHealthDetailsStatusFromResponseCode(t *testing.T) {
+	tests := []struct {
+		name       string
+		statusCode int
+		want       health.Status
+	}{
+		{"2xx passes", http.StatusOK, health.Pass},
+		{"4xx warns", http.StatusNotFound, health.Warn},
+		{"5xx fails", http.StatusInternalServerError, health.Fail},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(tt.statusCode)
+			}))
+			defer server.Close()
+
+			h := New("payments-api", http.MethodGet, server.URL, nil, time.Second)
+			details := h.HealthDetails()
+
+			rt := details["payments-api:responseTime"]
+			if len(rt) != 1 || rt[0].Status != tt.want {
+				t.Fatalf("expected status %v, got %+v", tt.want, rt)
+			}
+		})
+	}
+}
+
+func TestHealthDetailsFailsWhenUnreachable(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	url := server.URL
+	server.Close() // nothing is listening at url anymore
+
+	h := New("payments-api", http.MethodGet, url, nil, time.Second)
+	details := h.HealthDetails()
+
+	rt := details["payments-api:responseTime"]
+	if len(rt) != 1 || rt[0].Status != health.Fail || rt[0].Output == "" {
+		t.Fatalf("expected a failing responseTime entry with output, got %+v", rt)
+	}
+}
+
+func TestZeroTimeoutMeansNoTimeout(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	h := New("payments-api", http.MethodGet, server.URL, nil, 0)
+	details := h.HealthDetails()
+
+	if rt := details["payments-api:responseTime"]; len(rt) != 1 || rt[0].Status != health.Pass {
+		t.Errorf("a zero timeout should mean no deadline, not an immediate failure; got %+v", rt)
+	}
+}
+
+func TestTimeoutBoundsASlowRequest(t *testing.T) {
+	block := make(chan struct{})
+	defer close(block)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		<-r.Context().Done()
+	}))
+	defer server.Close()
+
+	h := New("payments-api", http.MethodGet, server.URL, nil, 10*time.Millisecond)
+	details := h.HealthDetails()
+
+	rt := details["payments-api:responseTime"]
+	if len(rt) != 1 || rt[0].Status != health.Fail || rt[0].Output == "" {
+		t.Fatalf("expected the timeout to fail the request instead of blocking forever, got %+v", rt)
+	}
+}
@@ -0,0 +1,98 @@
+// Package prometheus exposes a Service's health Details as Prometheus metrics, so that the
+// same signals surfaced via /health can be scraped and alerted on without polling and parsing
+// JSON.
+package prometheus
+
+import (
+	"context"
+	"github.com/nelkinda/health-go"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+var (
+	observedValueDesc = prometheus.NewDesc(
+		"health_component_observed_value",
+		"The most recently observed value for a health details measurement.",
+		[]string{"component", "measurement", "unit"}, nil,
+	)
+	statusDesc = prometheus.NewDesc(
+		"health_component_status",
+		"The status of a health details component: pass=1, warn=0.5, fail=0.",
+		[]string{"component", "measurement"}, nil,
+	)
+)
+
+// Collector is a prometheus.Collector that translates a Service's health Details into gauges
+// on every scrape.
+type Collector struct {
+	service *health.Service
+	kind    health.ProbeKind
+}
+
+// NewCollector creates a Collector that scrapes service, aggregating DetailsProviders
+// classified for kind; pass health.AllProbes to include every provider. A zero kind matches
+// no provider.
+func NewCollector(service *health.Service, kind health.ProbeKind) *Collector {
+	return &Collector{service: service, kind: kind}
+}
+
+// Describe implements prometheus.Collector.
+func (c *Collector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- observedValueDesc
+	ch <- statusDesc
+}
+
+// Collect implements prometheus.Collector.
+func (c *Collector) Collect(ch chan<- prometheus.Metric) {
+	details := c.service.Collect(context.Background(), c.kind)
+	for measurement, ds := range details {
+		component, name := splitDetailsKey(measurement)
+		for _, d := range ds {
+			ch <- prometheus.MustNewConstMetric(statusDesc, prometheus.GaugeValue, statusValue(d.Status), component, name)
+			if value, ok := numericValue(d.ObservedValue); ok {
+				ch <- prometheus.MustNewConstMetric(observedValueDesc, prometheus.GaugeValue, value, component, name, d.ObservedUnit)
+			}
+		}
+	}
+}
+
+// splitDetailsKey splits a "{componentName}:{measurementName}" details key into its parts,
+// per the RFC. If there is no colon, the whole key is treated as the component name.
+func splitDetailsKey(key string) (component, measurement string) {
+	for i := 0; i < len(key); i++ {
+		if key[i] == ':' {
+			return key[:i], key[i+1:]
+		}
+	}
+	return key, ""
+}
+
+// statusValue maps a health.Status to the numeric gauge value expected by ops dashboards.
+func statusValue(status health.Status) float64 {
+	switch status {
+	case health.Pass:
+		return 1
+	case health.Warn:
+		return 0.5
+	default:
+		return 0
+	}
+}
+
+// numericValue extracts a float64 from an ObservedValue, skipping non-numeric values safely.
+func numericValue(observedValue interface{}) (float64, bool) {
+	switch v := observedValue.(type) {
+	case float64:
+		return v, true
+	case float32:
+		return float64(v), true
+	case int:
+		return float64(v), true
+	case int32:
+		return float64(v), true
+	case int64:
+		return float64(v), true
+	default:
+		return 0, false
+	}
+}
@@ -0,0 +1,96 @@
+package prometheus
+
+import (
+	"github.com/nelkinda/health-go"
+	"github.com/prometheus/client_golang/prometheus"
+	dto "github.com/prometheus/client_model/go"
+	"net/http"
+	"testing"
+)
+
+// fakeProvider is a health.DetailsProvider that always reports one fixed Details entry.
+type fakeProvider struct {
+	name   string
+	status health.Status
+	value  interface{}
+	unit   string
+}
+
+func (p fakeProvider) HealthDetails() map[string][]health.Details {
+	return map[string][]health.Details{
+		p.name + ":responseTime": {{Status: p.status, ObservedValue: p.value, ObservedUnit: p.unit}},
+	}
+}
+
+func (p fakeProvider) AuthorizeHealth(r *http.Request) bool {
+	return true
+}
+
+func collectMetrics(c *Collector) []prometheus.Metric {
+	ch := make(chan prometheus.Metric, 10)
+	c.Collect(ch)
+	close(ch)
+	var metrics []prometheus.Metric
+	for m := range ch {
+		metrics = append(metrics, m)
+	}
+	return metrics
+}
+
+func TestCollectorEmitsStatusAndObservedValueGauges(t *testing.T) {
+	svc := health.New(health.Health{}, health.WithProvider("postgres", fakeProvider{name: "postgres", status: health.Pass, value: 12.5, unit: "ms"}))
+	c := NewCollector(svc, health.AllProbes)
+
+	metrics := collectMetrics(c)
+	if len(metrics) != 2 {
+		t.Fatalf("expected a status gauge and an observed value gauge, got %d metrics", len(metrics))
+	}
+
+	var sawStatus, sawValue bool
+	for _, m := range metrics {
+		var pb dto.Metric
+		if err := m.Write(&pb); err != nil {
+			t.Fatalf("writing metric: %v", err)
+		}
+		switch m.Desc() {
+		case statusDesc:
+			sawStatus = true
+			if pb.GetGauge().GetValue() != 1 {
+				t.Errorf("expected status gauge value 1 for Pass, got %v", pb.GetGauge().GetValue())
+			}
+		case observedValueDesc:
+			sawValue = true
+			if pb.GetGauge().GetValue() != 12.5 {
+				t.Errorf("expected observed value gauge 12.5, got %v", pb.GetGauge().GetValue())
+			}
+		}
+	}
+	if !sawStatus || !sawValue {
+		t.Errorf("expected both a status and an observed value gauge, sawStatus=%v sawValue=%v", sawStatus, sawValue)
+	}
+}
+
+func TestNonNumericObservedValueIsSkipped(t *testing.T) {
+	svc := health.New(health.Health{}, health.WithProvider("queue", fakeProvider{name: "queue", status: health.Warn, value: "degraded", unit: "state"}))
+	c := NewCollector(svc, health.AllProbes)
+
+	metrics := collectMetrics(c)
+	if len(metrics) != 1 {
+		t.Errorf("expected only the status gauge for a non-numeric observed value, got %d metrics", len(metrics))
+	}
+}
+
+func TestSplitDetailsKey(t *testing.T) {
+	tests := []struct {
+		key, component, measurement string
+	}{
+		{"postgres:responseTime", "postgres", "responseTime"},
+		{"uptime", "uptime", ""},
+	}
+	for _, tt := range tests {
+		component, measurement := splitDetailsKey(tt.key)
+		if component != tt.component || measurement != tt.measurement {
+			t.Errorf("splitDetailsKey(%q) = (%q, %q), want (%q, %q)", tt.key, component, measurement, tt.component, tt.measurement)
+		}
+	}
+}
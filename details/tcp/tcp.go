@@ -0,0 +1,60 @@
+// Package tcp provides a health.DetailsProvider that dials a host:port address, reporting
+// reachability and dial latency as RFC Health Check Response Format details.
+package tcp
+
+import (
+	"fmt"
+	"github.com/nelkinda/health-go"
+	"net"
+	"time"
+)
+
+// TCP is a health.DetailsProvider that dials a host:port address and reports whether the connection succeeds.
+type TCP struct {
+	health.AuthorizeAll
+	componentID string
+	address     string
+	timeout     time.Duration
+}
+
+// New creates a health.DetailsProvider that dials address (host:port) with the given timeout;
+// a non-positive timeout means no timeout is applied. componentID identifies the dependency in
+// the details key, e.g. "redis:responseTime".
+func New(componentID, address string, timeout time.Duration) *TCP {
+	return &TCP{componentID: componentID, address: address, timeout: timeout}
+}
+
+// HealthDetails implements health.DetailsProvider.
+func (t *TCP) HealthDetails() map[string][]health.Details {
+	now := time.Now().Format(health.ISO8601)
+	timeout := t.timeout
+	if timeout < 0 {
+		timeout = 0
+	}
+	start := time.Now()
+	conn, err := net.DialTimeout("tcp", t.address, timeout)
+	responseTime := time.Since(start)
+
+	status := health.Pass
+	output := ""
+	if err != nil {
+		status = health.Fail
+		output = fmt.Sprintf("dial %s failed: %v", t.address, err)
+	} else {
+		_ = conn.Close()
+	}
+
+	return map[string][]health.Details{
+		t.componentID + ":responseTime": {
+			{
+				ComponentID:   t.componentID,
+				ComponentType: "component",
+				ObservedValue: float64(responseTime.Milliseconds()),
+				ObservedUnit:  "ms",
+				Status:        status,
+				Time:          now,
+				Output:        output,
+			},
+		},
+	}
+}
@@ -0,0 +1,50 @@
+package tcp
+
+import (
+	"github.com/nelkinda/health-go"
+	"net"
+	"testing"
+	"time"
+)
+
+func TestHealthDetailsReportsPassOnSuccessfulDial(t *testing.T) {
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("net.Listen: %v", err)
+	}
+	defer ln.Close()
+	go func() {
+		for {
+			conn, err := ln.Accept()
+			if err != nil {
+				return
+			}
+			conn.Close()
+		}
+	}()
+
+	tc := New("redis", ln.Addr().String(), time.Second)
+	details := tc.HealthDetails()
+
+	rt := details["redis:responseTime"]
+	if len(rt) != 1 || rt[0].Status != health.Pass || rt[0].ComponentID != "redis" {
+		t.Fatalf("expected a passing responseTime entry for redis, got %+v", rt)
+	}
+}
+
+func TestHealthDetailsReportsFailWhenUnreachable(t *testing.T) {
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("net.Listen: %v", err)
+	}
+	addr := ln.Addr().String()
+	ln.Close() // nothing is listening on addr anymore
+
+	tc := New("redis", addr, time.Second)
+	details := tc.HealthDetails()
+
+	rt := details["redis:responseTime"]
+	if len(rt) != 1 || rt[0].Status != health.Fail || rt[0].Output == "" {
+		t.Fatalf("expected a failing responseTime entry with output, got %+v", rt)
+	}
+}
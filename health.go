@@ -17,8 +17,8 @@
 //  			Version: "1",
 //  			ReleaseId: "1.0.0-SNAPSHOT",
 //  		},
-//  		uptime.System(),
-//  		uptime.Process(),
+//  		health.WithProvider("system", uptime.System()),
+//  		health.WithProvider("process", uptime.Process()),
 //  	)
 //  	http.HandleFunc("/health", h.Handler)
 //  	http.ListenAndServe(":80", nil)
@@ -31,10 +31,14 @@
 package health
 
 import (
+	"context"
 	"encoding/json"
 	"github.com/nelkinda/http-go/header"
 	"github.com/nelkinda/http-go/mimetype"
 	"net/http"
+	"strings"
+	"sync"
+	"time"
 )
 
 // Status represents a health status.
@@ -165,6 +169,12 @@ type Details struct {
 
 	// links (optional) has the exact same meaning as the top-level "links" element, but for the sub-component/downstream dependency represented by the details object.
 	Links map[string]string `json:"links,omitempty"`
+
+	// stale is not part of the RFC. It is set by a WithProviderCache-backed provider when this
+	// Details was served from cache because the provider missed its WithProviderTimeout
+	// deadline, so callers can distinguish a genuinely fresh reading from a stale one without
+	// having to parse Output.
+	Stale bool `json:"stale,omitempty"`
 }
 
 const (
@@ -187,6 +197,51 @@ type DetailsProvider interface {
 	AuthorizeHealth(r *http.Request) bool
 }
 
+// ISO8601 is the time.Time layout this module's built-in details/* providers use to format
+// Details.Time: ISO8601 with fractional seconds, e.g. "2019-02-20T22:01:44.654015561Z".
+const ISO8601 = "2006-01-02T15:04:05.999999999Z07:00"
+
+// AuthorizeAll is an embeddable AuthorizeHealth implementation for DetailsProviders that have
+// no reason to restrict their details to specific callers.
+type AuthorizeAll struct{}
+
+// AuthorizeHealth implements DetailsProvider, authorizing every caller.
+func (AuthorizeAll) AuthorizeHealth(r *http.Request) bool {
+	return true
+}
+
+// ProbeKind classifies which Kubernetes-style probe(s) a DetailsProvider's check is relevant
+// to, so that, e.g., a slow-warming cache doesn't fail liveness.
+type ProbeKind int
+
+const (
+	// Liveness indicates the provider should be consulted to decide whether the process is alive.
+	Liveness ProbeKind = 1 << iota
+	// Readiness indicates the provider should be consulted to decide whether the service is ready for traffic.
+	Readiness
+	// Startup indicates the provider should be consulted to decide whether initialization has completed.
+	Startup
+)
+
+// AllProbes is the classification used for DetailsProviders that don't implement
+// ProbeClassifier, preserving their previous behavior of running on every endpoint.
+const AllProbes = Liveness | Readiness | Startup
+
+// ProbeClassifier lets a DetailsProvider restrict which Kubernetes-style probe(s) it
+// participates in. Providers that don't implement it are classified as AllProbes.
+type ProbeClassifier interface {
+	ProbeKinds() ProbeKind
+}
+
+// probeKindsOf returns the ProbeKind a DetailsProvider is classified for, defaulting to
+// AllProbes for providers that don't implement ProbeClassifier.
+func probeKindsOf(provider DetailsProvider) ProbeKind {
+	if classifier, ok := provider.(ProbeClassifier); ok {
+		return classifier.ProbeKinds()
+	}
+	return AllProbes
+}
+
 // Handler implements the health endpoint.
 // @Summary Service health
 // @Description Returns the service health according to the upcoming IETF RFC Health Check Response Format for HTTP APIs https://tools.ietf.org/id/draft-inadarei-api-health-check-02.html
@@ -194,6 +249,45 @@ type DetailsProvider interface {
 // @Success 200 {object} health.Health
 // @Router /health [GET]
 func (h *Service) Handler(w http.ResponseWriter, r *http.Request) {
+	h.serve(w, r, AllProbes)
+}
+
+// LivenessHandler implements a Kubernetes-style liveness probe endpoint, aggregating only
+// DetailsProviders classified for Liveness.
+// @Summary Liveness probe
+// @Description Returns whether the process is alive, per the RFC Health Check Response Format.
+// @Produce application/json
+// @Success 200 {object} health.Health
+// @Router /health/live [GET]
+func (h *Service) LivenessHandler(w http.ResponseWriter, r *http.Request) {
+	h.serve(w, r, Liveness)
+}
+
+// ReadinessHandler implements a Kubernetes-style readiness probe endpoint, aggregating only
+// DetailsProviders classified for Readiness.
+// @Summary Readiness probe
+// @Description Returns whether the service is ready for traffic, per the RFC Health Check Response Format.
+// @Produce application/json
+// @Success 200 {object} health.Health
+// @Router /health/ready [GET]
+func (h *Service) ReadinessHandler(w http.ResponseWriter, r *http.Request) {
+	h.serve(w, r, Readiness)
+}
+
+// StartupHandler implements a Kubernetes-style startup probe endpoint, aggregating only
+// DetailsProviders classified for Startup.
+// @Summary Startup probe
+// @Description Returns whether initialization has completed, per the RFC Health Check Response Format.
+// @Produce application/json
+// @Success 200 {object} health.Health
+// @Router /health/startup [GET]
+func (h *Service) StartupHandler(w http.ResponseWriter, r *http.Request) {
+	h.serve(w, r, Startup)
+}
+
+// serve handles a single probe endpoint: it collects Details from every DetailsProvider
+// classified for kind, aggregates their Status, and writes the RFC health+json response.
+func (h *Service) serve(w http.ResponseWriter, r *http.Request, kind ProbeKind) {
 	w.Header().Add(header.ContentType, mimetype.ApplicationHealthJson)
 	if r.Method == http.MethodOptions {
 		w.Header().Set("Allow", "OPTIONS, GET, HEAD")
@@ -205,27 +299,368 @@ func (h *Service) Handler(w http.ResponseWriter, r *http.Request) {
 		w.WriteHeader(http.StatusMethodNotAllowed)
 		return
 	}
-	w.WriteHeader(http.StatusOK)
-	h.template.Status = Pass
-	h.template.Details = make(map[string][]Details)
-	for _, detailsProvider := range h.detailsProviders {
-		detailsMap := detailsProvider.HealthDetails()
-		for detailsKey, details := range detailsMap {
-			h.template.Details[detailsKey] = append(h.template.Details[detailsKey], details...)
+	noCache := strings.Contains(r.Header.Get("Cache-Control"), "no-cache")
+	authorize := func(provider DetailsProvider) bool { return provider.AuthorizeHealth(r) }
+	response := h.template
+	response.Details = h.collectDetails(r.Context(), noCache, kind, authorize)
+	response.Status = h.aggregator.Aggregate(response.Details)
+	if h.authorizeFull != nil && !h.authorizeFull(r) {
+		response.Version = ""
+		response.ReleaseID = ""
+		response.ServiceID = ""
+		response.Details = stripComponentIDs(response.Details)
+	}
+	w.WriteHeader(h.statusCode(response.Status))
+	_ = json.NewEncoder(w).Encode(response)
+}
+
+// stripComponentIDs returns a copy of details with every ComponentID cleared and every details
+// key redacted, used to hide dependency topology from unauthenticated callers. The details key
+// itself, not just the ComponentID field, can name the component (e.g. "postgres:responseTime"),
+// so it is redacted the same way.
+func stripComponentIDs(details map[string][]Details) map[string][]Details {
+	stripped := make(map[string][]Details, len(details))
+	for detailsKey, ds := range details {
+		copied := make([]Details, len(ds))
+		for i, d := range ds {
+			d.ComponentID = ""
+			copied[i] = d
+		}
+		redactedKey := redactDetailsKey(detailsKey)
+		stripped[redactedKey] = append(stripped[redactedKey], copied...)
+	}
+	return stripped
+}
+
+// redactDetailsKey strips the "{componentName}:" prefix from a details key, leaving only the
+// measurementName, so a redacted response can't leak a dependency's name via its details key.
+// An empty key, or a key with nothing after its colon, is redacted to "redacted"; a non-empty
+// key with no colon is returned unchanged, as explained below.
+//
+// redactDetailsKey is idempotent: this package's own DetailsProviders never build a details key
+// without a colon, so a colon-less key reaching it here has already passed through redaction
+// once (by this function, or by the other redaction layer when both a provider's own
+// AuthorizeHealth and the service-level WithRedaction deny the same caller) and is returned
+// unchanged. Without this, re-redacting an already-redacted key would collapse every denied
+// provider's distinct measurements down onto the single literal key "redacted".
+func redactDetailsKey(key string) string {
+	i := strings.IndexByte(key, ':')
+	if i < 0 {
+		if key == "" {
+			return "redacted"
+		}
+		return key
+	}
+	if i+1 < len(key) {
+		return key[i+1:]
+	}
+	return "redacted"
+}
+
+// statusCode maps a top-level Status to the HTTP response code to send, per the RFC:
+// "fail" MUST use a 4xx/5xx code, while "pass" and "warn" use 200.
+func (h *Service) statusCode(status Status) int {
+	if status == Fail {
+		return h.failStatusCode
+	}
+	return http.StatusOK
+}
+
+// Collect runs every registered DetailsProvider classified for kind and returns their merged
+// Details. It is exported so integrations, such as details/prometheus, can sample the current
+// health details without going through an HTTP handler.
+func (h *Service) Collect(ctx context.Context, kind ProbeKind) map[string][]Details {
+	return h.collectDetails(ctx, false, kind, func(DetailsProvider) bool { return true })
+}
+
+// collectDetails runs every registered DetailsProvider classified for kind concurrently,
+// bounding each one by its configured ProviderOption timeout (if any), and merges their
+// results keyed by details key. A provider that misses its deadline is reported as a
+// synthesized Warn entry instead of blocking the response, falling back to its last cached
+// result when one is available. A provider for which authorize returns false has its Details
+// redacted down to a status-only stub before being merged in.
+func (h *Service) collectDetails(ctx context.Context, noCache bool, kind ProbeKind, authorize func(DetailsProvider) bool) map[string][]Details {
+	var relevant []*providerConfig
+	for _, p := range h.providers {
+		if probeKindsOf(p.provider)&kind != 0 {
+			relevant = append(relevant, p)
+		}
+	}
+	results := make([]map[string][]Details, len(relevant))
+	var wg sync.WaitGroup
+	for i, p := range relevant {
+		wg.Add(1)
+		go func(i int, p *providerConfig) {
+			defer wg.Done()
+			result := h.runProvider(ctx, p, noCache)
+			if !authorize(p.provider) {
+				result = redactDetails(result)
+			}
+			results[i] = result
+		}(i, p)
+	}
+	wg.Wait()
+	details := make(map[string][]Details)
+	for _, result := range results {
+		for detailsKey, d := range result {
+			details[detailsKey] = append(details[detailsKey], d...)
 		}
 	}
-	_ = json.NewEncoder(w).Encode(h.template)
+	return details
+}
+
+// redactDetails reduces each Details to a status-only stub and redacts the details key itself,
+// for a provider whose AuthorizeHealth denied this caller.
+func redactDetails(details map[string][]Details) map[string][]Details {
+	redacted := make(map[string][]Details, len(details))
+	for detailsKey, ds := range details {
+		stubs := make([]Details, len(ds))
+		for i, d := range ds {
+			stubs[i] = Details{Status: d.Status}
+		}
+		redactedKey := redactDetailsKey(detailsKey)
+		redacted[redactedKey] = append(redacted[redactedKey], stubs...)
+	}
+	return redacted
+}
+
+// runProvider executes a single DetailsProvider, serving a cached result when one is fresh
+// and consulting the cache for a stale fallback when the provider times out.
+func (h *Service) runProvider(ctx context.Context, p *providerConfig, noCache bool) map[string][]Details {
+	if p.cache != nil && !noCache {
+		if cached, fresh := p.cache.get(); fresh {
+			return cached
+		}
+	}
+	deadline := ctx
+	if p.timeout > 0 {
+		var cancel context.CancelFunc
+		deadline, cancel = context.WithTimeout(ctx, p.timeout)
+		defer cancel()
+	}
+	done := make(chan map[string][]Details, 1)
+	go func() { done <- p.provider.HealthDetails() }()
+	select {
+	case details := <-done:
+		if p.cache != nil {
+			p.cache.set(details)
+		}
+		return details
+	case <-deadline.Done():
+		if p.cache != nil {
+			if cached, _ := p.cache.get(); cached != nil {
+				return staleDetails(cached)
+			}
+		}
+		return timeoutDetails(p.name, p.timeout)
+	}
+}
+
+// staleDetails marks each Details as Stale, noting that the value was served from cache
+// because the provider did not return a fresh result in time, without disturbing whatever
+// Output the provider had actually reported.
+func staleDetails(cached map[string][]Details) map[string][]Details {
+	stale := make(map[string][]Details, len(cached))
+	for detailsKey, ds := range cached {
+		copied := make([]Details, len(ds))
+		for i, d := range ds {
+			d.Stale = true
+			copied[i] = d
+		}
+		stale[detailsKey] = copied
+	}
+	return stale
+}
+
+// timeoutDetails synthesizes a Warn entry for a provider that has no cached result to fall
+// back on when it misses its deadline. name identifies the provider that timed out, so that
+// when several providers time out on the same request their entries don't collide under a
+// shared details key.
+func timeoutDetails(name string, timeout time.Duration) map[string][]Details {
+	return map[string][]Details{
+		name + ":responseTime": {
+			{
+				ComponentID: name,
+				Status:      Warn,
+				Output:      "provider did not respond within " + timeout.String(),
+			},
+		},
+	}
+}
+
+// StatusAggregator computes a top-level Status from the Details collected across all
+// registered DetailsProviders.
+type StatusAggregator interface {
+	Aggregate(details map[string][]Details) Status
+}
+
+// StatusAggregatorFunc adapts a plain function to a StatusAggregator.
+type StatusAggregatorFunc func(details map[string][]Details) Status
+
+// Aggregate implements StatusAggregator.
+func (f StatusAggregatorFunc) Aggregate(details map[string][]Details) Status {
+	return f(details)
+}
+
+// worstOfAggregator is the default StatusAggregator: any Fail status yields Fail, else any
+// Warn yields Warn, else Pass.
+func worstOfAggregator(details map[string][]Details) Status {
+	worst := Pass
+	for _, ds := range details {
+		for _, d := range ds {
+			switch d.Status {
+			case Fail:
+				return Fail
+			case Warn:
+				worst = Warn
+			}
+		}
+	}
+	return worst
+}
+
+// providerConfig holds the execution policy for a single registered DetailsProvider.
+type providerConfig struct {
+	// name identifies this provider in synthesized entries (e.g. a timeout), so that when
+	// several providers miss their deadline on the same request, each one is still
+	// attributable to the component that produced it.
+	name     string
+	provider DetailsProvider
+	timeout  time.Duration
+	cache    *providerCache
+}
+
+// ProviderOption configures how a Service executes a single DetailsProvider.
+type ProviderOption func(*providerConfig)
+
+// WithProviderTimeout bounds how long the Service waits for this DetailsProvider before
+// reporting a synthesized timeout entry in its place. It only stops the Service from waiting:
+// DetailsProvider.HealthDetails takes no context.Context, by design, so there is no way to
+// cancel the provider's own call once it is running. If HealthDetails never returns, the
+// goroutine running it (and any connection it holds) is abandoned, not killed, and leaks for
+// the life of the process. DetailsProviders that make blocking calls (network dials, queries,
+// HTTP requests, ...) must bound those calls themselves, e.g. with their own timeout parameter
+// or a context.WithTimeout, so that WithProviderTimeout is a backstop, not the only timeout.
+func WithProviderTimeout(timeout time.Duration) ProviderOption {
+	return func(c *providerConfig) { c.timeout = timeout }
+}
+
+// WithProviderCache enables a TTL-based cache for this DetailsProvider, so that expensive
+// checks (TCP dials, database pings, ...) are not repeated on every request. Callers can
+// force revalidation by sending a "Cache-Control: no-cache" request header.
+func WithProviderCache(ttl time.Duration) ProviderOption {
+	return func(c *providerConfig) { c.cache = newProviderCache(ttl) }
+}
+
+// providerCache remembers the last Details a provider returned, for ttl, so repeated
+// requests don't have to re-run an expensive check.
+type providerCache struct {
+	ttl time.Duration
+
+	mu       sync.Mutex
+	details  map[string][]Details
+	cachedAt time.Time
+}
+
+func newProviderCache(ttl time.Duration) *providerCache {
+	return &providerCache{ttl: ttl}
+}
+
+// get returns the last cached Details, along with whether it is still within its ttl.
+func (c *providerCache) get() (map[string][]Details, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.details == nil {
+		return nil, false
+	}
+	return c.details, time.Since(c.cachedAt) <= c.ttl
+}
+
+func (c *providerCache) set(details map[string][]Details) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.details = details
+	c.cachedAt = time.Now()
 }
 
 // Service describes an instance of a health service.
 type Service struct {
-	// The providers for details of this health service.
-	detailsProviders []DetailsProvider
+	// The providers for details of this health service, together with their execution policy.
+	providers []*providerConfig
 	// The template for the outer health response.
-	template         Health
+	template Health
+	// aggregator computes the top-level Status from the collected Details.
+	aggregator StatusAggregator
+	// failStatusCode is the HTTP status code written when the aggregated Status is Fail.
+	failStatusCode int
+	// authorizeFull, when set, decides whether a request may see the service's full,
+	// unredacted details. A nil authorizeFull authorizes every caller, preserving the
+	// historical behavior of always returning full details.
+	authorizeFull func(*http.Request) bool
+}
+
+// Option configures a Service being built by New.
+type Option func(*Service)
+
+// WithProvider registers a DetailsProvider with the Service under name, optionally configuring
+// its execution with ProviderOptions such as WithProviderTimeout and WithProviderCache. name
+// identifies the provider in any details it is not itself able to produce, e.g. a synthesized
+// timeout entry, and should be a stable, unique identifier such as "postgres" or "auth-api".
+func WithProvider(name string, provider DetailsProvider, opts ...ProviderOption) Option {
+	p := &providerConfig{name: name, provider: provider}
+	for _, opt := range opts {
+		opt(p)
+	}
+	return func(h *Service) {
+		h.providers = append(h.providers, p)
+	}
+}
+
+// WithAggregator replaces the default worst-of StatusAggregator with a custom policy, e.g.
+// one that only warns when a datastore is down but a cache is still up.
+func WithAggregator(aggregator StatusAggregator) Option {
+	return func(h *Service) {
+		h.aggregator = aggregator
+	}
+}
+
+// WithFailStatusCode overrides the HTTP status code written when the aggregated Status is
+// Fail. It defaults to http.StatusServiceUnavailable.
+func WithFailStatusCode(code int) Option {
+	return func(h *Service) {
+		h.failStatusCode = code
+	}
+}
+
+// WithRedaction gates full detail visibility behind authorize: requests for which authorize
+// returns false get Version, ReleaseID, ServiceID, and every componentId stripped from the
+// response. authorize can inspect a bearer token, check an IP allowlist, or apply any other
+// policy the caller needs.
+//
+// This is independent of DetailsProvider.AuthorizeHealth, which Handler already consults to
+// decide whether a given provider's details are reduced to status-only stubs.
+func WithRedaction(authorize func(r *http.Request) bool) Option {
+	return func(h *Service) {
+		h.authorizeFull = authorize
+	}
 }
 
 // New creates a new health service.
-func New(template Health, detailsProviders ...DetailsProvider) *Service {
-	return &Service{detailsProviders: detailsProviders, template: template}
+// Providers are registered via WithProvider, e.g.:
+//
+//	h := health.New(
+//		health.Health{Version: "1", ReleaseID: "1.0.0-SNAPSHOT"},
+//		health.WithProvider("system", uptime.System()),
+//		health.WithProvider("process", uptime.Process()),
+//	)
+func New(template Health, opts ...Option) *Service {
+	h := &Service{
+		template:       template,
+		aggregator:     StatusAggregatorFunc(worstOfAggregator),
+		failStatusCode: http.StatusServiceUnavailable,
+	}
+	for _, opt := range opts {
+		opt(h)
+	}
+	return h
 }
@@ -0,0 +1,72 @@
+package health
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestWorstOfAggregator(t *testing.T) {
+	tests := []struct {
+		name   string
+		status []Status
+		want   Status
+	}{
+		{"no details", nil, Pass},
+		{"all pass", []Status{Pass, Pass}, Pass},
+		{"one warn", []Status{Pass, Warn}, Warn},
+		{"fail beats warn and pass", []Status{Warn, Fail, Pass}, Fail},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			details := make(map[string][]Details, len(tt.status))
+			for i, s := range tt.status {
+				details[string(rune('a'+i))] = []Details{{Status: s}}
+			}
+			if got := worstOfAggregator(details); got != tt.want {
+				t.Errorf("worstOfAggregator(%v) = %v, want %v", tt.status, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestHandlerMapsAggregatedStatusToHTTPStatusCode(t *testing.T) {
+	h := New(
+		Health{},
+		WithProvider("down", authProvider{componentID: "down", authorized: true}),
+		WithAggregator(StatusAggregatorFunc(func(map[string][]Details) Status { return Fail })),
+		WithFailStatusCode(http.StatusTeapot),
+	)
+
+	w := httptest.NewRecorder()
+	h.Handler(w, httptest.NewRequest(http.MethodGet, "/health", nil))
+
+	if w.Code != http.StatusTeapot {
+		t.Errorf("expected the configured fail status code %d, got %d", http.StatusTeapot, w.Code)
+	}
+}
+
+func TestHandlerDefaultsToServiceUnavailableOnFail(t *testing.T) {
+	h := New(
+		Health{},
+		WithProvider("down", failingProvider{}),
+	)
+
+	w := httptest.NewRecorder()
+	h.Handler(w, httptest.NewRequest(http.MethodGet, "/health", nil))
+
+	if w.Code != http.StatusServiceUnavailable {
+		t.Errorf("expected the default fail status code %d, got %d", http.StatusServiceUnavailable, w.Code)
+	}
+}
+
+// failingProvider is a DetailsProvider that always reports a Fail status.
+type failingProvider struct{}
+
+func (failingProvider) HealthDetails() map[string][]Details {
+	return map[string][]Details{"down:responseTime": {{Status: Fail}}}
+}
+
+func (failingProvider) AuthorizeHealth(r *http.Request) bool {
+	return true
+}
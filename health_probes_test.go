@@ -0,0 +1,65 @@
+package health
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+// classifiedProvider is a DetailsProvider that also implements ProbeClassifier, restricting
+// itself to the given ProbeKind(s).
+type classifiedProvider struct {
+	name string
+	kind ProbeKind
+}
+
+func (p classifiedProvider) HealthDetails() map[string][]Details {
+	return map[string][]Details{p.name + ":responseTime": {{ComponentID: p.name, Status: Pass}}}
+}
+
+func (p classifiedProvider) AuthorizeHealth(r *http.Request) bool {
+	return true
+}
+
+func (p classifiedProvider) ProbeKinds() ProbeKind {
+	return p.kind
+}
+
+func TestProbeHandlersRouteByClassification(t *testing.T) {
+	h := New(
+		Health{},
+		WithProvider("cache", classifiedProvider{name: "cache", kind: Readiness | Startup}),
+		WithProvider("unclassified", authProvider{componentID: "unclassified", authorized: true}),
+	)
+
+	cases := []struct {
+		name     string
+		handler  func(http.ResponseWriter, *http.Request)
+		wantCold bool // whether the slow-warming "cache" provider should appear
+	}{
+		{"liveness", h.LivenessHandler, false},
+		{"readiness", h.ReadinessHandler, true},
+		{"startup", h.StartupHandler, true},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			tc.handler(w, httptest.NewRequest(http.MethodGet, "/health", nil))
+
+			var resp Health
+			if err := json.NewDecoder(w.Body).Decode(&resp); err != nil {
+				t.Fatalf("decoding response: %v", err)
+			}
+
+			_, hasCache := resp.Details["cache:responseTime"]
+			if hasCache != tc.wantCold {
+				t.Errorf("cache provider presence = %v, want %v", hasCache, tc.wantCold)
+			}
+			if _, hasUnclassified := resp.Details["unclassified:responseTime"]; !hasUnclassified {
+				t.Errorf("a provider without a ProbeClassifier should run on every probe endpoint")
+			}
+		})
+	}
+}
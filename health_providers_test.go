@@ -0,0 +1,130 @@
+package health
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// slowProvider is a DetailsProvider that counts its invocations and optionally blocks for
+// delay before returning, for exercising concurrency, timeouts, and caching.
+type slowProvider struct {
+	name  string
+	delay time.Duration
+	calls *int32
+}
+
+func (p slowProvider) HealthDetails() map[string][]Details {
+	if p.calls != nil {
+		atomic.AddInt32(p.calls, 1)
+	}
+	if p.delay > 0 {
+		time.Sleep(p.delay)
+	}
+	return map[string][]Details{
+		p.name + ":responseTime": {{ComponentID: p.name, Status: Pass}},
+	}
+}
+
+func (p slowProvider) AuthorizeHealth(r *http.Request) bool {
+	return true
+}
+
+// serveAndDecode runs req through h.Handler and decodes the resulting Health response.
+func serveAndDecode(t *testing.T, h *Service, req *http.Request) (Health, *httptest.ResponseRecorder) {
+	t.Helper()
+	w := httptest.NewRecorder()
+	h.Handler(w, req)
+	var resp Health
+	if err := json.NewDecoder(w.Body).Decode(&resp); err != nil {
+		t.Fatalf("decoding response: %v", err)
+	}
+	return resp, w
+}
+
+func TestCollectDetailsRunsProvidersConcurrently(t *testing.T) {
+	const delay = 100 * time.Millisecond
+	h := New(
+		Health{},
+		WithProvider("a", slowProvider{name: "a", delay: delay}),
+		WithProvider("b", slowProvider{name: "b", delay: delay}),
+		WithProvider("c", slowProvider{name: "c", delay: delay}),
+	)
+
+	start := time.Now()
+	h.Handler(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/health", nil))
+	elapsed := time.Since(start)
+
+	if elapsed >= 2*delay {
+		t.Errorf("providers should run concurrently, took %v for 3 providers each delaying %v", elapsed, delay)
+	}
+}
+
+func TestRunProviderAttributesTimeoutsToTheRightComponent(t *testing.T) {
+	h := New(
+		Health{},
+		WithProvider("slow-a", slowProvider{name: "slow-a", delay: time.Second}, WithProviderTimeout(10*time.Millisecond)),
+		WithProvider("slow-b", slowProvider{name: "slow-b", delay: time.Second}, WithProviderTimeout(10*time.Millisecond)),
+	)
+
+	resp, _ := serveAndDecode(t, h, httptest.NewRequest(http.MethodGet, "/health", nil))
+
+	for _, name := range []string{"slow-a", "slow-b"} {
+		entries := resp.Details[name+":responseTime"]
+		if len(entries) != 1 {
+			t.Fatalf("expected exactly one synthesized timeout entry for %q, got %+v", name, resp.Details)
+		}
+		if entries[0].Status != Warn || entries[0].ComponentID != name {
+			t.Errorf("timeout entry for %q should be Warn and attributed to it, got %+v", name, entries[0])
+		}
+	}
+}
+
+func TestProviderCacheServesFreshResultsWithoutRecalling(t *testing.T) {
+	var calls int32
+	h := New(
+		Health{},
+		WithProvider("cached", slowProvider{name: "cached", calls: &calls}, WithProviderCache(time.Minute)),
+	)
+
+	h.Handler(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/health", nil))
+	h.Handler(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/health", nil))
+
+	if got := atomic.LoadInt32(&calls); got != 1 {
+		t.Errorf("expected the cached provider to be invoked once across two requests within its ttl, got %d calls", got)
+	}
+
+	req := httptest.NewRequest(http.MethodGet, "/health", nil)
+	req.Header.Set("Cache-Control", "no-cache")
+	h.Handler(httptest.NewRecorder(), req)
+
+	if got := atomic.LoadInt32(&calls); got != 2 {
+		t.Errorf("expected Cache-Control: no-cache to force revalidation, got %d calls", got)
+	}
+}
+
+func TestProviderCacheFallsBackToStaleResultOnTimeout(t *testing.T) {
+	h := New(
+		Health{},
+		WithProvider("flaky", slowProvider{name: "flaky", delay: time.Second}, WithProviderTimeout(10*time.Millisecond), WithProviderCache(time.Millisecond)),
+	)
+	// Seed the cache with an old result, then let it go stale so the handler has to try the
+	// (slow) provider again and fall back to this cached entry when that attempt times out.
+	h.providers[0].cache.set(map[string][]Details{
+		"flaky:responseTime": {{ComponentID: "flaky", Status: Pass}},
+	})
+	time.Sleep(5 * time.Millisecond)
+
+	resp, _ := serveAndDecode(t, h, httptest.NewRequest(http.MethodGet, "/health", nil))
+
+	entries := resp.Details["flaky:responseTime"]
+	if len(entries) != 1 || !entries[0].Stale {
+		t.Fatalf("expected a stale cached entry when the provider times out, got %+v", entries)
+	}
+	if entries[0].Status != Pass {
+		t.Errorf("a stale fallback should keep the cached Status, got %+v", entries[0])
+	}
+}
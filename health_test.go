@@ -0,0 +1,145 @@
+package health
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+// authProvider is a DetailsProvider whose AuthorizeHealth decision is fixed at construction,
+// for exercising Handler's per-provider redaction.
+type authProvider struct {
+	componentID string
+	authorized  bool
+}
+
+func (p authProvider) HealthDetails() map[string][]Details {
+	return map[string][]Details{
+		p.componentID + ":responseTime": {
+			{ComponentID: p.componentID, Status: Pass, ObservedValue: 1.0, ObservedUnit: "ms"},
+		},
+	}
+}
+
+func (p authProvider) AuthorizeHealth(r *http.Request) bool {
+	return p.authorized
+}
+
+func TestHandlerRedactsUnauthorizedProviders(t *testing.T) {
+	h := New(
+		Health{Version: "1", ServiceID: "svc"},
+		WithProvider("public", authProvider{componentID: "public", authorized: true}),
+		WithProvider("secret", authProvider{componentID: "secret", authorized: false}),
+	)
+
+	w := httptest.NewRecorder()
+	h.Handler(w, httptest.NewRequest(http.MethodGet, "/health", nil))
+
+	var resp Health
+	if err := json.NewDecoder(w.Body).Decode(&resp); err != nil {
+		t.Fatalf("decoding response: %v", err)
+	}
+
+	public := resp.Details["public:responseTime"]
+	if len(public) != 1 || public[0].ComponentID != "public" || public[0].ObservedUnit != "ms" {
+		t.Errorf("authorized provider should keep its full details, got %+v", public)
+	}
+
+	if _, leaked := resp.Details["secret:responseTime"]; leaked {
+		t.Errorf("unauthorized provider's details key should not leak its component name")
+	}
+	secret := resp.Details["responseTime"]
+	if len(secret) != 1 || secret[0].Status != Pass {
+		t.Fatalf("unauthorized provider should still report its status under a redacted key, got %+v", secret)
+	}
+	if secret[0].ComponentID != "" || secret[0].ObservedUnit != "" {
+		t.Errorf("unauthorized provider's details should be redacted to a status-only stub, got %+v", secret[0])
+	}
+}
+
+func TestHandlerAppliesServiceLevelRedaction(t *testing.T) {
+	h := New(
+		Health{Version: "1", ReleaseID: "1.0.0", ServiceID: "svc"},
+		WithProvider("public", authProvider{componentID: "public", authorized: true}),
+		WithRedaction(func(r *http.Request) bool { return r.Header.Get("Authorization") != "" }),
+	)
+
+	w := httptest.NewRecorder()
+	h.Handler(w, httptest.NewRequest(http.MethodGet, "/health", nil))
+
+	var resp Health
+	if err := json.NewDecoder(w.Body).Decode(&resp); err != nil {
+		t.Fatalf("decoding response: %v", err)
+	}
+	if resp.Version != "" || resp.ReleaseID != "" || resp.ServiceID != "" {
+		t.Errorf("unauthenticated caller should have top-level identifying fields stripped, got %+v", resp)
+	}
+	if _, leaked := resp.Details["public:responseTime"]; leaked {
+		t.Errorf("unauthenticated caller should not see the dependency's name in a details key")
+	}
+	if resp.Details["responseTime"][0].ComponentID != "" {
+		t.Errorf("unauthenticated caller should have componentId stripped from details")
+	}
+
+	w = httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodGet, "/health", nil)
+	req.Header.Set("Authorization", "Bearer token")
+	h.Handler(w, req)
+
+	if err := json.NewDecoder(w.Body).Decode(&resp); err != nil {
+		t.Fatalf("decoding response: %v", err)
+	}
+	if resp.Version != "1" || resp.ReleaseID != "1.0.0" || resp.ServiceID != "svc" {
+		t.Errorf("authenticated caller should see full top-level fields, got %+v", resp)
+	}
+	if resp.Details["public:responseTime"][0].ComponentID != "public" {
+		t.Errorf("authenticated caller should see full details")
+	}
+}
+
+// multiMeasurementProvider reports two distinct measurements for the same component and always
+// denies AuthorizeHealth, for exercising redaction when a provider is denied at both the
+// provider level and the service level in the same request.
+type multiMeasurementProvider struct {
+	componentID string
+}
+
+func (p multiMeasurementProvider) HealthDetails() map[string][]Details {
+	return map[string][]Details{
+		p.componentID + ":responseTime": {{ComponentID: p.componentID, Status: Pass}},
+		p.componentID + ":connections":  {{ComponentID: p.componentID, Status: Warn}},
+	}
+}
+
+func (p multiMeasurementProvider) AuthorizeHealth(r *http.Request) bool {
+	return false
+}
+
+func TestHandlerDoesNotCollapseMeasurementsWhenDeniedAtBothLayers(t *testing.T) {
+	h := New(
+		Health{Version: "1", ServiceID: "svc"},
+		WithProvider("secret", multiMeasurementProvider{componentID: "secret"}),
+		WithRedaction(func(r *http.Request) bool { return false }),
+	)
+
+	w := httptest.NewRecorder()
+	h.Handler(w, httptest.NewRequest(http.MethodGet, "/health", nil))
+
+	var resp Health
+	if err := json.NewDecoder(w.Body).Decode(&resp); err != nil {
+		t.Fatalf("decoding response: %v", err)
+	}
+
+	responseTime := resp.Details["responseTime"]
+	connections := resp.Details["connections"]
+	if len(responseTime) != 1 || responseTime[0].Status != Pass {
+		t.Errorf("expected responseTime to survive double redaction under its own key, got %+v", resp.Details)
+	}
+	if len(connections) != 1 || connections[0].Status != Warn {
+		t.Errorf("expected connections to survive double redaction under its own key, got %+v", resp.Details)
+	}
+	if _, collapsed := resp.Details["redacted"]; collapsed {
+		t.Errorf("distinct measurements should not collapse onto a single \"redacted\" key when denied at both layers, got %+v", resp.Details)
+	}
+}